@@ -0,0 +1,60 @@
+package ensure
+
+import "regexp"
+
+// ComparisonAssertion is satisfied by assertions that compare an actual value
+// against an expected one, such as DeepEqual, NotDeepEqual and SameElements.
+// It allows table-driven tests to pick an assertion per row without wrapping
+// it in a closure.
+type ComparisonAssertion func(t Fataler, actual, expected interface{}, extra ...interface{})
+
+// ValueAssertion is satisfied by assertions that examine a single value, such
+// as Nil and NotNil.
+type ValueAssertion func(t Fataler, v interface{}, a ...interface{})
+
+// BoolAssertion is satisfied by assertions that examine a boolean, such as
+// True and False.
+type BoolAssertion func(t Fataler, v bool, a ...interface{})
+
+// ErrorAssertion is satisfied by assertions that examine an error, such as
+// the assertions returned by ErrMatches.
+type ErrorAssertion func(t Fataler, err error, a ...interface{})
+
+var (
+	_ ComparisonAssertion = DeepEqual
+	_ ComparisonAssertion = NotDeepEqual
+	_ ComparisonAssertion = SameElements
+	_ ValueAssertion      = Nil
+	_ ValueAssertion      = NotNil
+	_ BoolAssertion       = True
+	_ BoolAssertion       = False
+)
+
+// ErrMatches adapts Err into an ErrorAssertion for use in table-driven tests.
+// Err takes a *regexp.Regexp rather than a string, so the pattern is
+// compiled up front and closed over.
+func ErrMatches(pattern string) ErrorAssertion {
+	re := regexp.MustCompile(pattern)
+	return func(t Fataler, err error, a ...interface{}) {
+		helper(t).Helper()
+		Err(t, err, re, a...)
+	}
+}
+
+// HasSubstring adapts StringContains into a ValueAssertion for use in
+// table-driven tests. v is expected to be a string.
+func HasSubstring(substr string) ValueAssertion {
+	return func(t Fataler, v interface{}, a ...interface{}) {
+		helper(t).Helper()
+		StringContains(t, v.(string), substr, a...)
+	}
+}
+
+// LacksSubstring adapts StringDoesNotContain into a ValueAssertion for use in
+// table-driven tests. v is expected to be a string.
+func LacksSubstring(substr string) ValueAssertion {
+	return func(t Fataler, v interface{}, a ...interface{}) {
+		helper(t).Helper()
+		StringDoesNotContain(t, v.(string), substr, a...)
+	}
+}
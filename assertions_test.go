@@ -0,0 +1,42 @@
+package ensure
+
+import "testing"
+
+func TestTableDrivenAssertions(t *testing.T) {
+	cases := []struct {
+		name      string
+		actual    interface{}
+		expected  interface{}
+		assertion ComparisonAssertion
+	}{
+		{name: "DeepEqual", actual: 1, expected: 1, assertion: DeepEqual},
+		{name: "NotDeepEqual", actual: 1, expected: 2, assertion: NotDeepEqual},
+		{name: "SameElements", actual: []int{1, 2}, expected: []interface{}{2, 1}, assertion: SameElements},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.assertion(t, c.actual, c.expected)
+		})
+	}
+}
+
+func TestErrMatches(t *testing.T) {
+	var c capture
+	assertion := ErrMatches("bar")
+	assertion(&c, nil)
+	c.Equal(t, `expected error: "bar" but got a nil error`)
+}
+
+func TestHasSubstring(t *testing.T) {
+	var c capture
+	assertion := HasSubstring("bar")
+	assertion(&c, "foo")
+	c.Equal(t, `expected substring "bar" was not found in "foo"`)
+}
+
+func TestLacksSubstring(t *testing.T) {
+	var c capture
+	assertion := LacksSubstring("o")
+	assertion(&c, "foo")
+	c.Equal(t, `substring "o" was not supposed to be found in "foo"`)
+}
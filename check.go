@@ -0,0 +1,218 @@
+package ensure
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Tester defines the minimal interface necessary to record a non-fatal
+// failure. testing.T & testing.B satisfy this for example.
+type Tester interface {
+	Errorf(format string, args ...interface{})
+}
+
+type testerHelper interface {
+	Tester
+	Helper()
+}
+
+type testerHelperWrapper struct {
+	Tester
+}
+
+func (testerHelperWrapper) Helper() {}
+
+func checkHelper(t Tester) testerHelper {
+	if t, ok := t.(testerHelper); ok {
+		return t
+	}
+	return testerHelperWrapper{t}
+}
+
+// errorf records the cond's message via Errorf and always returns false,
+// allowing the test to keep running instead of stopping as fatal does.
+func errorf(t Tester, c cond) bool {
+	checkHelper(t).Helper()
+	t.Errorf("%s", c.String())
+	return false
+}
+
+// CheckErr is the non-fatal counterpart to Err. It reports a failure via
+// Errorf instead of Fatal, and returns true if the error satisfies the given
+// regular expression.
+func CheckErr(t Tester, err error, re *regexp.Regexp, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	if err == nil && re == nil {
+		return true
+	}
+
+	if err == nil && re != nil {
+		return errorf(t, cond{
+			Format:     `expected error: "%s" but got a nil error`,
+			FormatArgs: []interface{}{re},
+			Extra:      a,
+		})
+	}
+
+	if err != nil && re == nil {
+		return errorf(t, cond{
+			Format:     `unexpected error: %+v`,
+			FormatArgs: []interface{}{err},
+			Extra:      a,
+		})
+	}
+
+	if !re.MatchString(err.Error()) {
+		return errorf(t, cond{
+			Format:     `expected error: "%s" but got "%+v"`,
+			FormatArgs: []interface{}{re, err},
+			Extra:      a,
+		})
+	}
+	return true
+}
+
+// CheckDeepEqual is the non-fatal counterpart to DeepEqual. It reports a
+// failure via Errorf instead of Fatal, and returns true if actual and
+// expected are reflect.DeepEqual.
+func CheckDeepEqual(t Tester, actual, expected interface{}, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	if !reflect.DeepEqual(actual, expected) {
+		return errorf(t, deepEqualCond(actual, expected, a))
+	}
+	return true
+}
+
+// CheckNotDeepEqual is the non-fatal counterpart to NotDeepEqual. It reports
+// a failure via Errorf instead of Fatal, and returns true if actual and
+// expected are not reflect.DeepEqual.
+func CheckNotDeepEqual(t Tester, actual, expected interface{}, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	if reflect.DeepEqual(actual, expected) {
+		return errorf(t, cond{
+			Format:     "expected two different values, but got the same:\n%s",
+			FormatArgs: []interface{}{tsdump(actual)},
+			Extra:      a,
+		})
+	}
+	return true
+}
+
+// CheckNil is the non-fatal counterpart to Nil. It reports a failure via
+// Errorf instead of Fatal, and returns true if v is nil.
+func CheckNil(t Tester, v interface{}, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	if v == nil {
+		return true
+	}
+
+	// Special case errors for prettier output.
+	if _, ok := v.(error); ok {
+		return errorf(t, cond{
+			Format:     `unexpected error: %+v`,
+			FormatArgs: []interface{}{v},
+			Extra:      a,
+		})
+	}
+
+	vs := tsdump(v)
+	sp := " "
+	if strings.Contains(vs[:len(vs)-1], "\n") {
+		sp = "\n"
+	}
+	return errorf(t, cond{
+		Format:     "expected nil value but got:%s%s",
+		FormatArgs: []interface{}{sp, vs},
+		Extra:      a,
+	})
+}
+
+// CheckNotNil is the non-fatal counterpart to NotNil. It reports a failure
+// via Errorf instead of Fatal, and returns true if v is not nil.
+func CheckNotNil(t Tester, v interface{}, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	if v == nil {
+		return errorf(t, cond{
+			Format: "expected a value but got nil",
+			Extra:  a,
+		})
+	}
+	return true
+}
+
+// CheckTrue is the non-fatal counterpart to True. It reports a failure via
+// Errorf instead of Fatal, and returns true if v is true.
+func CheckTrue(t Tester, v bool, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	if !v {
+		return errorf(t, cond{
+			Format: "expected true but got false",
+			Extra:  a,
+		})
+	}
+	return true
+}
+
+// CheckFalse is the non-fatal counterpart to False. It reports a failure via
+// Errorf instead of Fatal, and returns true if v is false.
+func CheckFalse(t Tester, v bool, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	if v {
+		return errorf(t, cond{
+			Format: "expected false but got true",
+			Extra:  a,
+		})
+	}
+	return true
+}
+
+// CheckStringContains is the non-fatal counterpart to StringContains. It
+// reports a failure via Errorf instead of Fatal, and returns true if s
+// contains substr.
+func CheckStringContains(t Tester, s, substr string, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	return CheckContains(t, s, substr, a...)
+}
+
+// CheckStringDoesNotContain is the non-fatal counterpart to
+// StringDoesNotContain. It reports a failure via Errorf instead of Fatal,
+// and returns true if s does not contain substr.
+func CheckStringDoesNotContain(t Tester, s, substr string, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	return CheckNotContains(t, s, substr, a...)
+}
+
+// CheckSameElements is the non-fatal counterpart to SameElements. It reports
+// a failure via Errorf instead of Fatal, and returns true if the two given
+// slices contain the same elements, ignoring order.
+func CheckSameElements(t Tester, actual, expected interface{}, extra ...interface{}) bool {
+	checkHelper(t).Helper()
+	actualSlice := toInterfaceSlice(actual)
+	expectedSlice := toInterfaceSlice(expected)
+	if len(actualSlice) != len(expectedSlice) {
+		return errorf(t, cond{
+			Format:     "expected same elements but found slices of different lengths:\nACTUAL:\n%s\nEXPECTED\n%s",
+			FormatArgs: []interface{}{tsdump(actual), tsdump(expected)},
+			Extra:      extra,
+		})
+	}
+
+	ok := true
+	used := map[int]bool{}
+outer:
+	for _, a := range expectedSlice {
+		for i, b := range actualSlice {
+			if !used[i] && reflect.DeepEqual(a, b) {
+				used[i] = true
+				continue outer
+			}
+		}
+		ok = errorf(t, cond{
+			Format:     "missing expected element:\nACTUAL:\n%s\nEXPECTED:\n%s\nMISSING ELEMENT\n%s",
+			FormatArgs: []interface{}{tsdump(actual), tsdump(expected), tsdump(a)},
+			Extra:      extra,
+		})
+	}
+	return ok
+}
@@ -0,0 +1,104 @@
+package ensure
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+)
+
+func TestCheckDeepEqualSuccess(t *testing.T) {
+	True(t, CheckDeepEqual(t, "foo", "foo"))
+}
+
+func TestCheckDeepEqualFailure(t *testing.T) {
+	var c capture
+	False(t, CheckDeepEqual(&c, "foo", "bar"))
+	c.Equal(t, `expected these to be equal:
+ACTUAL:
+(string) (len=3) "foo"
+
+EXPECTED:
+(string) (len=3) "bar"`)
+}
+
+func TestCheckDeepEqualPercentInValue(t *testing.T) {
+	var c capture
+	False(t, CheckDeepEqual(&c, "50% off", "full price"))
+	c.Contains(t, `"50% off"`)
+}
+
+func TestCheckDeepEqualKeepsRunning(t *testing.T) {
+	var c capture
+	first := CheckDeepEqual(&c, 1, 2)
+	second := CheckDeepEqual(&c, 3, 3)
+	False(t, first)
+	True(t, second)
+}
+
+func TestCheckNotDeepEqual(t *testing.T) {
+	var c capture
+	False(t, CheckNotDeepEqual(&c, 1, 1))
+	c.Equal(t, `expected two different values, but got the same:
+(int) 1`)
+}
+
+func TestCheckNil(t *testing.T) {
+	var c capture
+	False(t, CheckNil(&c, 1))
+	c.Equal(t, "expected nil value but got: (int) 1")
+}
+
+func TestCheckNotNil(t *testing.T) {
+	var c capture
+	False(t, CheckNotNil(&c, nil))
+	c.Equal(t, `expected a value but got nil`)
+}
+
+func TestCheckTrue(t *testing.T) {
+	var c capture
+	False(t, CheckTrue(&c, false))
+	c.Equal(t, `expected true but got false`)
+}
+
+func TestCheckFalse(t *testing.T) {
+	True(t, CheckFalse(t, false))
+	var c capture
+	False(t, CheckFalse(&c, true))
+	c.Equal(t, `expected false but got true`)
+}
+
+func TestCheckErr(t *testing.T) {
+	var c capture
+	e := errors.New("foo")
+	False(t, CheckErr(&c, e, regexp.MustCompile("bar")))
+	c.Equal(t, "expected error: \"bar\" but got \"foo\"")
+}
+
+func TestCheckStringContains(t *testing.T) {
+	var c capture
+	False(t, CheckStringContains(&c, "foo", "bar"))
+	c.Equal(t, "expected substring \"bar\" was not found in \"foo\"")
+}
+
+func TestCheckStringDoesNotContain(t *testing.T) {
+	var c capture
+	False(t, CheckStringDoesNotContain(&c, "foo", "o"))
+	c.Equal(t, "substring \"o\" was not supposed to be found in \"foo\"")
+}
+
+func TestCheckSameElements(t *testing.T) {
+	True(t, CheckSameElements(t, []int{1, 2}, []interface{}{2, 1}))
+
+	var c capture
+	False(t, CheckSameElements(&c, []int{1, 2}, []interface{}{1}))
+	c.Equal(t, `expected same elements but found slices of different lengths:
+ACTUAL:
+([]int) (len=2 cap=2) {
+ (int) 1,
+ (int) 2
+}
+EXPECTED
+([]interface {}) (len=1 cap=1) {
+ (int) 1
+}`)
+}
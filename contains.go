@@ -0,0 +1,205 @@
+package ensure
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Contains ensures container holds element. container may be a string
+// (substr match against element, itself a string), a slice/array (element
+// matched via reflect.DeepEqual), or a map (element matched against its
+// keys).
+func Contains(t Fataler, container, element interface{}, a ...interface{}) {
+	helper(t).Helper()
+	if s, ok := container.(string); ok {
+		substr, ok := element.(string)
+		if !ok {
+			fatal(t, cannotCheckStringContainsCond(element, a))
+			return
+		}
+		if !strings.Contains(s, substr) {
+			fatal(t, stringContainsCond(s, substr, a))
+		}
+		return
+	}
+
+	found, ok := containsElement(container, element)
+	if !ok {
+		fatal(t, cannotCheckContainsCond(container, a))
+		return
+	}
+	if !found {
+		fatal(t, containsCond(container, element, a))
+	}
+}
+
+// CheckContains is the non-fatal counterpart to Contains.
+func CheckContains(t Tester, container, element interface{}, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	if s, ok := container.(string); ok {
+		substr, ok := element.(string)
+		if !ok {
+			return errorf(t, cannotCheckStringContainsCond(element, a))
+		}
+		if !strings.Contains(s, substr) {
+			return errorf(t, stringContainsCond(s, substr, a))
+		}
+		return true
+	}
+
+	found, ok := containsElement(container, element)
+	if !ok {
+		return errorf(t, cannotCheckContainsCond(container, a))
+	}
+	if !found {
+		return errorf(t, containsCond(container, element, a))
+	}
+	return true
+}
+
+// NotContains ensures container does not hold element. See Contains for the
+// supported container kinds.
+func NotContains(t Fataler, container, element interface{}, a ...interface{}) {
+	helper(t).Helper()
+	if s, ok := container.(string); ok {
+		substr, ok := element.(string)
+		if !ok {
+			fatal(t, cannotCheckStringContainsCond(element, a))
+			return
+		}
+		if strings.Contains(s, substr) {
+			fatal(t, cond{
+				Format:     `substring "%s" was not supposed to be found in "%s"`,
+				FormatArgs: []interface{}{substr, s},
+				Extra:      a,
+			})
+		}
+		return
+	}
+
+	found, ok := containsElement(container, element)
+	if !ok {
+		fatal(t, cannotCheckContainsCond(container, a))
+		return
+	}
+	if found {
+		fatal(t, notContainsCond(container, element, a))
+	}
+}
+
+// CheckNotContains is the non-fatal counterpart to NotContains.
+func CheckNotContains(t Tester, container, element interface{}, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	if s, ok := container.(string); ok {
+		substr, ok := element.(string)
+		if !ok {
+			return errorf(t, cannotCheckStringContainsCond(element, a))
+		}
+		if strings.Contains(s, substr) {
+			return errorf(t, cond{
+				Format:     `substring "%s" was not supposed to be found in "%s"`,
+				FormatArgs: []interface{}{substr, s},
+				Extra:      a,
+			})
+		}
+		return true
+	}
+
+	found, ok := containsElement(container, element)
+	if !ok {
+		return errorf(t, cannotCheckContainsCond(container, a))
+	}
+	if found {
+		return errorf(t, notContainsCond(container, element, a))
+	}
+	return true
+}
+
+// containsElement reports whether element was found in container (found),
+// and whether container was of a kind we know how to search (ok). It
+// recovers from any panic triggered by an unexpected container/element
+// combination and reports that as ok == false.
+func containsElement(container, element interface{}) (found, ok bool) {
+	defer func() {
+		if recover() != nil {
+			found, ok = false, false
+		}
+	}()
+
+	containerValue := reflect.ValueOf(container)
+	switch containerValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < containerValue.Len(); i++ {
+			if reflect.DeepEqual(containerValue.Index(i).Interface(), element) {
+				return true, true
+			}
+		}
+		return false, true
+	case reflect.Map:
+		for _, k := range containerValue.MapKeys() {
+			if reflect.DeepEqual(k.Interface(), element) {
+				return true, true
+			}
+		}
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// cannotCheckContainsCond builds the failure cond used when container isn't
+// a kind Contains/NotContains know how to search.
+func cannotCheckContainsCond(container interface{}, extra []interface{}) cond {
+	return cond{
+		Format:     "cannot check contains on type %T",
+		FormatArgs: []interface{}{container},
+		Extra:      extra,
+	}
+}
+
+// cannotCheckStringContainsCond builds the failure cond used when container
+// is a string but element isn't, so the substring check itself can't run.
+func cannotCheckStringContainsCond(element interface{}, extra []interface{}) cond {
+	return cond{
+		Format:     "cannot check a string container contains an element of type %T; element must be a string",
+		FormatArgs: []interface{}{element},
+		Extra:      extra,
+	}
+}
+
+// containsCond builds the failure cond shared by Contains and CheckContains.
+func containsCond(container, element interface{}, extra []interface{}) cond {
+	containerDump := tsdump(container)
+	elementDump := tsdump(element)
+	if strings.Contains(containerDump, "\n") || strings.Contains(elementDump, "\n") {
+		return cond{
+			Format:     "expected element was not found in container:\nEXPECTED ELEMENT:\n%s\nACTUAL CONTAINER:\n%s",
+			FormatArgs: []interface{}{elementDump, containerDump},
+			Extra:      extra,
+		}
+	}
+	return cond{
+		Format:     "expected element %s was not found in %s",
+		FormatArgs: []interface{}{elementDump, containerDump},
+		Extra:      extra,
+	}
+}
+
+// notContainsCond builds the failure cond shared by NotContains and
+// CheckNotContains.
+func notContainsCond(container, element interface{}, extra []interface{}) cond {
+	containerDump := tsdump(container)
+	elementDump := tsdump(element)
+	if strings.Contains(containerDump, "\n") || strings.Contains(elementDump, "\n") {
+		return cond{
+			Format:     "element was not supposed to be found in container:\nELEMENT:\n%s\nACTUAL CONTAINER:\n%s",
+			FormatArgs: []interface{}{elementDump, containerDump},
+			Extra:      extra,
+		}
+	}
+	return cond{
+		Format:     "element %s was not supposed to be found in %s",
+		FormatArgs: []interface{}{elementDump, containerDump},
+		Extra:      extra,
+	}
+}
@@ -0,0 +1,80 @@
+package ensure
+
+import "testing"
+
+func TestContainsSlice(t *testing.T) {
+	Contains(t, []int{1, 2, 3}, 2)
+}
+
+func TestContainsSliceMissing(t *testing.T) {
+	var c capture
+	Contains(&c, []int{1, 2, 3}, 4)
+	c.Equal(t, `expected element was not found in container:
+EXPECTED ELEMENT:
+(int) 4
+ACTUAL CONTAINER:
+([]int) (len=3 cap=3) {
+ (int) 1,
+ (int) 2,
+ (int) 3
+}`)
+}
+
+func TestContainsMap(t *testing.T) {
+	Contains(t, map[string]int{"a": 1}, "a")
+}
+
+func TestContainsMapMissing(t *testing.T) {
+	var c capture
+	Contains(&c, map[string]int{"a": 1}, "b")
+	c.Contains(t, `EXPECTED ELEMENT:
+(string) (len=1) "b"`)
+}
+
+func TestContainsString(t *testing.T) {
+	Contains(t, "foobar", "bar")
+}
+
+func TestContainsUnsupportedType(t *testing.T) {
+	var c capture
+	Contains(&c, 5, 2)
+	c.Equal(t, "cannot check contains on type int")
+}
+
+func TestContainsStringElementMismatch(t *testing.T) {
+	var c capture
+	Contains(&c, "foobar", 5)
+	c.Equal(t, "cannot check a string container contains an element of type int; element must be a string")
+}
+
+func TestNotContainsSlice(t *testing.T) {
+	NotContains(t, []int{1, 2, 3}, 4)
+}
+
+func TestNotContainsSliceFound(t *testing.T) {
+	var c capture
+	NotContains(&c, []int{1, 2, 3}, 2)
+	c.Equal(t, `element was not supposed to be found in container:
+ELEMENT:
+(int) 2
+ACTUAL CONTAINER:
+([]int) (len=3 cap=3) {
+ (int) 1,
+ (int) 2,
+ (int) 3
+}`)
+}
+
+func TestCheckContains(t *testing.T) {
+	True(t, CheckContains(t, []int{1, 2, 3}, 2))
+
+	var c capture
+	False(t, CheckContains(&c, []int{1, 2, 3}, 4))
+}
+
+func TestCheckNotContains(t *testing.T) {
+	True(t, CheckNotContains(t, []int{1, 2, 3}, 4))
+
+	var c capture
+	False(t, CheckNotContains(&c, []int{1, 2, 3}, 2))
+}
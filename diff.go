@@ -0,0 +1,84 @@
+package ensure
+
+import (
+	"os"
+	"strings"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// diffEnabled controls whether DeepEqual/CheckDeepEqual and
+// StringContains/CheckStringContains render a unified diff for large
+// failures instead of dumping both values in full. Set ENSURE_DIFF=0 to opt
+// back into the full dump, e.g. for tooling that parses failure output.
+var diffEnabled = os.Getenv("ENSURE_DIFF") != "0"
+
+// Above these thresholds a failure is considered large enough to prefer a
+// unified diff over a full side-by-side dump.
+const (
+	diffLineThreshold = 8
+	diffByteThreshold = 400
+)
+
+// useDiff reports whether a unified diff should be rendered for a and b.
+func useDiff(a, b string) bool {
+	return diffEnabled && (isLarge(a) || isLarge(b))
+}
+
+func isLarge(s string) bool {
+	return len(s) > diffByteThreshold || strings.Count(s, "\n") > diffLineThreshold
+}
+
+// unifiedDiff renders a unified diff between from and to, labeling the hunk
+// with fromLabel/toLabel (e.g. "expected"/"actual") to match the caller's
+// header.
+func unifiedDiff(fromLabel, toLabel, from, to string) string {
+	diff := difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: fromLabel,
+		ToFile:   toLabel,
+		Context:  3,
+	}
+	// GetUnifiedDiffString only errors if the underlying writer fails, which
+	// can't happen when writing to a strings.Builder.
+	text, _ := difflib.GetUnifiedDiffString(diff)
+	return text
+}
+
+// deepEqualCond builds the failure cond shared by DeepEqual and
+// CheckDeepEqual, so both surfaces render identically.
+func deepEqualCond(actual, expected interface{}, extra []interface{}) cond {
+	trimmedActual := tsdump(actual)
+	trimmedExpected := tsdump(expected)
+	if useDiff(trimmedActual, trimmedExpected) {
+		return cond{
+			Format:     "expected these to be equal, diff (--- expected, +++ actual):\n%s",
+			FormatArgs: []interface{}{unifiedDiff("expected", "actual", trimmedExpected, trimmedActual)},
+			Extra:      extra,
+		}
+	}
+	return cond{
+		Format:     "expected these to be equal:\nACTUAL:\n%s\nEXPECTED:\n%s",
+		FormatArgs: []interface{}{spew.Sdump(actual), trimmedExpected},
+		Extra:      extra,
+	}
+}
+
+// stringContainsCond builds the failure cond shared by StringContains and
+// CheckStringContains, so both surfaces render identically.
+func stringContainsCond(s, substr string, extra []interface{}) cond {
+	if useDiff(s, substr) {
+		return cond{
+			Format:     "expected substring was not found, diff (--- substring, +++ actual):\n%s",
+			FormatArgs: []interface{}{unifiedDiff("substring", "actual", substr, s)},
+			Extra:      extra,
+		}
+	}
+	return cond{
+		Format:     stringContainsFormat(s, substr),
+		FormatArgs: []interface{}{substr, s},
+		Extra:      extra,
+	}
+}
@@ -0,0 +1,38 @@
+package ensure
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDeepEqualLargeValuesUseDiff(t *testing.T) {
+	var c capture
+	actual := strings.Repeat("aaaaaaaaaa", 50) + "-actual"
+	expected := strings.Repeat("aaaaaaaaaa", 50) + "-expected"
+	DeepEqual(&c, actual, expected)
+	c.Contains(t, "expected these to be equal, diff (--- expected, +++ actual):")
+	c.Contains(t, `-(string) (len=509)`)
+	c.Contains(t, `+(string) (len=507)`)
+}
+
+func TestDeepEqualLargeValuesDiffCanBeDisabled(t *testing.T) {
+	defer func(enabled bool) { diffEnabled = enabled }(diffEnabled)
+	diffEnabled = false
+
+	var c capture
+	actual := strings.Repeat("aaaaaaaaaa", 50) + "-actual"
+	expected := strings.Repeat("aaaaaaaaaa", 50) + "-expected"
+	DeepEqual(&c, actual, expected)
+	c.Contains(t, "ACTUAL:")
+	c.Contains(t, "EXPECTED:")
+}
+
+func TestStringContainsLargeValuesUseDiff(t *testing.T) {
+	var c capture
+	s := strings.Repeat("a\n", 20) + "actual-line\n"
+	substr := strings.Repeat("a\n", 20) + "missing-line\n"
+	StringContains(&c, s, substr)
+	c.Contains(t, "expected substring was not found, diff (--- substring, +++ actual):")
+	c.Contains(t, "--- substring")
+	c.Contains(t, "+++ actual")
+}
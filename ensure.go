@@ -4,6 +4,11 @@
 // The various functions here show a useful error message automatically
 // including identifying source location. They additionally support arbitary
 // arguments which will be printed using the spew library.
+//
+// Every assertion here except PanicDeepEqual has a non-fatal counterpart
+// prefixed with Check (for example DeepEqual/CheckDeepEqual) which records a
+// failure via Errorf instead of stopping the test, allowing a single test to
+// accumulate multiple failures. See check.go for those.
 package ensure
 
 import (
@@ -41,9 +46,10 @@ func helper(t Fataler) fatalerHelper {
 }
 
 // cond represents a condition that wasn't satisfied, and is useful to generate
-// log messages.
+// log messages. It is shared by the Fatal-based assertions in this file and
+// the Errorf-based assertions in check.go so both surfaces produce identical
+// output.
 type cond struct {
-	Fataler    Fataler
 	Format     string
 	FormatArgs []interface{}
 	Extra      []interface{}
@@ -62,9 +68,9 @@ func (c cond) String() string {
 }
 
 // fatal triggers the fatal and logs the cond's message.
-func fatal(c cond) {
-	helper(c.Fataler).Helper()
-	c.Fataler.Fatal(c.String())
+func fatal(t Fataler, c cond) {
+	helper(t).Helper()
+	t.Fatal(c.String())
 }
 
 // Err ensures the error satisfies the given regular expression.
@@ -75,8 +81,7 @@ func Err(t Fataler, err error, re *regexp.Regexp, a ...interface{}) {
 	}
 
 	if err == nil && re != nil {
-		fatal(cond{
-			Fataler:    t,
+		fatal(t, cond{
 			Format:     `expected error: "%s" but got a nil error`,
 			FormatArgs: []interface{}{re},
 			Extra:      a,
@@ -85,8 +90,7 @@ func Err(t Fataler, err error, re *regexp.Regexp, a ...interface{}) {
 	}
 
 	if err != nil && re == nil {
-		fatal(cond{
-			Fataler:    t,
+		fatal(t, cond{
 			Format:     `unexpected error: %+v`,
 			FormatArgs: []interface{}{err},
 			Extra:      a,
@@ -95,8 +99,7 @@ func Err(t Fataler, err error, re *regexp.Regexp, a ...interface{}) {
 	}
 
 	if !re.MatchString(err.Error()) {
-		fatal(cond{
-			Fataler:    t,
+		fatal(t, cond{
 			Format:     `expected error: "%s" but got "%+v"`,
 			FormatArgs: []interface{}{re, err},
 			Extra:      a,
@@ -109,12 +112,7 @@ func Err(t Fataler, err error, re *regexp.Regexp, a ...interface{}) {
 func DeepEqual(t Fataler, actual, expected interface{}, a ...interface{}) {
 	helper(t).Helper()
 	if !reflect.DeepEqual(actual, expected) {
-		fatal(cond{
-			Fataler:    t,
-			Format:     "expected these to be equal:\nACTUAL:\n%s\nEXPECTED:\n%s",
-			FormatArgs: []interface{}{spew.Sdump(actual), tsdump(expected)},
-			Extra:      a,
-		})
+		fatal(t, deepEqualCond(actual, expected, a))
 	}
 }
 
@@ -123,8 +121,7 @@ func DeepEqual(t Fataler, actual, expected interface{}, a ...interface{}) {
 func NotDeepEqual(t Fataler, actual, expected interface{}, a ...interface{}) {
 	helper(t).Helper()
 	if reflect.DeepEqual(actual, expected) {
-		fatal(cond{
-			Fataler:    t,
+		fatal(t, cond{
 			Format:     "expected two different values, but got the same:\n%s",
 			FormatArgs: []interface{}{tsdump(actual)},
 			Extra:      a,
@@ -144,15 +141,13 @@ func Nil(t Fataler, v interface{}, a ...interface{}) {
 	if v != nil {
 		// Special case errors for prettier output.
 		if _, ok := v.(error); ok {
-			fatal(cond{
-				Fataler:    t,
+			fatal(t, cond{
 				Format:     `unexpected error: %+v`,
 				FormatArgs: []interface{}{v},
 				Extra:      a,
 			})
 		} else {
-			fatal(cond{
-				Fataler:    t,
+			fatal(t, cond{
 				Format:     "expected nil value but got:%s%s",
 				FormatArgs: []interface{}{sp, vs},
 				Extra:      a,
@@ -165,10 +160,9 @@ func Nil(t Fataler, v interface{}, a ...interface{}) {
 func NotNil(t Fataler, v interface{}, a ...interface{}) {
 	helper(t).Helper()
 	if v == nil {
-		fatal(cond{
-			Fataler: t,
-			Format:  "expected a value but got nil",
-			Extra:   a,
+		fatal(t, cond{
+			Format: "expected a value but got nil",
+			Extra:  a,
 		})
 	}
 }
@@ -177,10 +171,9 @@ func NotNil(t Fataler, v interface{}, a ...interface{}) {
 func True(t Fataler, v bool, a ...interface{}) {
 	helper(t).Helper()
 	if !v {
-		fatal(cond{
-			Fataler: t,
-			Format:  "expected true but got false",
-			Extra:   a,
+		fatal(t, cond{
+			Format: "expected true but got false",
+			Extra:  a,
 		})
 	}
 }
@@ -189,45 +182,35 @@ func True(t Fataler, v bool, a ...interface{}) {
 func False(t Fataler, v bool, a ...interface{}) {
 	helper(t).Helper()
 	if v {
-		fatal(cond{
-			Fataler: t,
-			Format:  "expected false but got true",
-			Extra:   a,
+		fatal(t, cond{
+			Format: "expected false but got true",
+			Extra:  a,
 		})
 	}
 }
 
-// StringContains ensures string s contains the string substr.
+// StringContains ensures string s contains the string substr. It is a thin
+// wrapper around Contains, kept for backward compatibility.
 func StringContains(t Fataler, s, substr string, a ...interface{}) {
 	helper(t).Helper()
-	if !strings.Contains(s, substr) {
-		format := `expected substring "%s" was not found in "%s"`
-
-		// use multi line output if either string contains newlines
-		if strings.Contains(s, "\n") || strings.Contains(substr, "\n") {
-			format = "expected substring was not found:\nEXPECTED SUBSTRING:\n%s\nACTUAL:\n%s"
-		}
-
-		fatal(cond{
-			Fataler:    t,
-			Format:     format,
-			FormatArgs: []interface{}{substr, s},
-			Extra:      a,
-		})
-	}
+	Contains(t, s, substr, a...)
 }
 
 // StringDoesNotContain ensures string s does not contain the string substr.
+// It is a thin wrapper around NotContains, kept for backward compatibility.
 func StringDoesNotContain(t Fataler, s, substr string, a ...interface{}) {
 	helper(t).Helper()
-	if strings.Contains(s, substr) {
-		fatal(cond{
-			Fataler:    t,
-			Format:     `substring "%s" was not supposed to be found in "%s"`,
-			FormatArgs: []interface{}{substr, s},
-			Extra:      a,
-		})
+	NotContains(t, s, substr, a...)
+}
+
+// stringContainsFormat picks the single or multi line format for
+// StringContains/CheckStringContains depending on whether either input spans
+// multiple lines.
+func stringContainsFormat(s, substr string) string {
+	if strings.Contains(s, "\n") || strings.Contains(substr, "\n") {
+		return "expected substring was not found:\nEXPECTED SUBSTRING:\n%s\nACTUAL:\n%s"
 	}
+	return `expected substring "%s" was not found in "%s"`
 }
 
 // SameElements ensures the two given slices contain the same elements,
@@ -237,8 +220,7 @@ func SameElements(t Fataler, actual, expected interface{}, extra ...interface{})
 	actualSlice := toInterfaceSlice(actual)
 	expectedSlice := toInterfaceSlice(expected)
 	if len(actualSlice) != len(expectedSlice) {
-		fatal(cond{
-			Fataler:    t,
+		fatal(t, cond{
 			Format:     "expected same elements but found slices of different lengths:\nACTUAL:\n%s\nEXPECTED\n%s",
 			FormatArgs: []interface{}{tsdump(actual), tsdump(expected)},
 			Extra:      extra,
@@ -254,8 +236,7 @@ outer:
 				continue outer
 			}
 		}
-		fatal(cond{
-			Fataler:    t,
+		fatal(t, cond{
 			Format:     "missing expected element:\nACTUAL:\n%s\nEXPECTED:\n%s\nMISSING ELEMENT\n%s",
 			FormatArgs: []interface{}{tsdump(actual), tsdump(expected), tsdump(a)},
 			Extra:      extra,
@@ -272,8 +253,7 @@ func PanicDeepEqual(t Fataler, expected interface{}, a ...interface{}) {
 	}
 	actual := recover()
 	if !reflect.DeepEqual(actual, expected) {
-		fatal(cond{
-			Fataler:    t,
+		fatal(t, cond{
 			Format:     "expected these to be equal:\nACTUAL:\n%s\nEXPECTED:\n%s",
 			FormatArgs: []interface{}{spew.Sdump(actual), tsdump(expected)},
 			Extra:      a,
@@ -19,6 +19,10 @@ func (c *capture) Fatal(a ...interface{}) {
 	fmt.Fprint(&c.Buffer, a...)
 }
 
+func (c *capture) Errorf(format string, args ...interface{}) {
+	fmt.Fprintf(&c.Buffer, format, args...)
+}
+
 func (c *capture) Equal(t testing.TB, expected string) {
 	helper(t).Helper()
 	DeepEqual(t, c.String(), expected)
@@ -0,0 +1,137 @@
+package ensure
+
+import "reflect"
+
+// Len ensures object has the expected length. object may be an Array, Chan,
+// Map, Slice or String; anything else fails with a clean message rather
+// than panicking.
+func Len(t Fataler, object interface{}, expected int, a ...interface{}) {
+	helper(t).Helper()
+	length, ok := getLen(object)
+	if !ok {
+		fatal(t, cannotLenCond(object, a))
+		return
+	}
+	if length != expected {
+		fatal(t, cond{
+			Format:     "expected length %d but got length %d for:\n%s",
+			FormatArgs: []interface{}{expected, length, tsdump(object)},
+			Extra:      a,
+		})
+	}
+}
+
+// CheckLen is the non-fatal counterpart to Len.
+func CheckLen(t Tester, object interface{}, expected int, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	length, ok := getLen(object)
+	if !ok {
+		return errorf(t, cannotLenCond(object, a))
+	}
+	if length != expected {
+		return errorf(t, cond{
+			Format:     "expected length %d but got length %d for:\n%s",
+			FormatArgs: []interface{}{expected, length, tsdump(object)},
+			Extra:      a,
+		})
+	}
+	return true
+}
+
+// Empty ensures object has a length of zero. See Len for the supported
+// kinds.
+func Empty(t Fataler, object interface{}, a ...interface{}) {
+	helper(t).Helper()
+	length, ok := getLen(object)
+	if !ok {
+		fatal(t, cannotLenCond(object, a))
+		return
+	}
+	if length != 0 {
+		fatal(t, cond{
+			Format:     "expected an empty value but got length %d for:\n%s",
+			FormatArgs: []interface{}{length, tsdump(object)},
+			Extra:      a,
+		})
+	}
+}
+
+// CheckEmpty is the non-fatal counterpart to Empty.
+func CheckEmpty(t Tester, object interface{}, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	length, ok := getLen(object)
+	if !ok {
+		return errorf(t, cannotLenCond(object, a))
+	}
+	if length != 0 {
+		return errorf(t, cond{
+			Format:     "expected an empty value but got length %d for:\n%s",
+			FormatArgs: []interface{}{length, tsdump(object)},
+			Extra:      a,
+		})
+	}
+	return true
+}
+
+// NotEmpty ensures object has a non-zero length. See Len for the supported
+// kinds.
+func NotEmpty(t Fataler, object interface{}, a ...interface{}) {
+	helper(t).Helper()
+	length, ok := getLen(object)
+	if !ok {
+		fatal(t, cannotLenCond(object, a))
+		return
+	}
+	if length == 0 {
+		fatal(t, cond{
+			Format:     "expected a non-empty value but got:\n%s",
+			FormatArgs: []interface{}{tsdump(object)},
+			Extra:      a,
+		})
+	}
+}
+
+// CheckNotEmpty is the non-fatal counterpart to NotEmpty.
+func CheckNotEmpty(t Tester, object interface{}, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	length, ok := getLen(object)
+	if !ok {
+		return errorf(t, cannotLenCond(object, a))
+	}
+	if length == 0 {
+		return errorf(t, cond{
+			Format:     "expected a non-empty value but got:\n%s",
+			FormatArgs: []interface{}{tsdump(object)},
+			Extra:      a,
+		})
+	}
+	return true
+}
+
+// getLen returns the length of object via reflection, and whether object was
+// of a kind that has a length at all.
+func getLen(object interface{}) (length int, ok bool) {
+	defer func() {
+		if recover() != nil {
+			length, ok = 0, false
+		}
+	}()
+
+	rv := reflect.ValueOf(object)
+	switch rv.Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len(), true
+	default:
+		return 0, false
+	}
+}
+
+// cannotLenCond builds the failure cond used when object isn't a kind that
+// has a length.
+func cannotLenCond(object interface{}, extra []interface{}) cond {
+	return cond{
+		Format:     "cannot take len() of type %T",
+		FormatArgs: []interface{}{object},
+		Extra:      extra,
+	}
+}
@@ -0,0 +1,72 @@
+package ensure
+
+import "testing"
+
+func TestLen(t *testing.T) {
+	Len(t, []int{1, 2, 3}, 3)
+	Len(t, "foo", 3)
+	Len(t, map[string]int{"a": 1}, 1)
+}
+
+func TestLenFailure(t *testing.T) {
+	var c capture
+	Len(&c, []int{1, 2, 3}, 2)
+	c.Equal(t, `expected length 2 but got length 3 for:
+([]int) (len=3 cap=3) {
+ (int) 1,
+ (int) 2,
+ (int) 3
+}`)
+}
+
+func TestLenUnsupportedType(t *testing.T) {
+	var c capture
+	Len(&c, 5, 0)
+	c.Equal(t, "cannot take len() of type int")
+}
+
+func TestEmpty(t *testing.T) {
+	Empty(t, []int{})
+	Empty(t, "")
+}
+
+func TestEmptyFailure(t *testing.T) {
+	var c capture
+	Empty(&c, []int{1})
+	c.Equal(t, `expected an empty value but got length 1 for:
+([]int) (len=1 cap=1) {
+ (int) 1
+}`)
+}
+
+func TestNotEmpty(t *testing.T) {
+	NotEmpty(t, []int{1})
+	NotEmpty(t, "foo")
+}
+
+func TestNotEmptyFailure(t *testing.T) {
+	var c capture
+	NotEmpty(&c, []int{})
+	c.Contains(t, "expected a non-empty value but got:")
+}
+
+func TestCheckLen(t *testing.T) {
+	True(t, CheckLen(t, []int{1, 2}, 2))
+
+	var c capture
+	False(t, CheckLen(&c, []int{1, 2}, 3))
+}
+
+func TestCheckEmpty(t *testing.T) {
+	True(t, CheckEmpty(t, ""))
+
+	var c capture
+	False(t, CheckEmpty(&c, "foo"))
+}
+
+func TestCheckNotEmpty(t *testing.T) {
+	True(t, CheckNotEmpty(t, "foo"))
+
+	var c capture
+	False(t, CheckNotEmpty(&c, ""))
+}
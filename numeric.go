@@ -0,0 +1,280 @@
+package ensure
+
+import "reflect"
+
+// Greater ensures a is greater than b. a and b may be any signed/unsigned
+// integer or float type, and need not be the same type as each other.
+func Greater(t Fataler, a, b interface{}, extra ...interface{}) {
+	helper(t).Helper()
+	compare(t, a, b, func(c int) bool { return c > 0 }, "greater than", extra)
+}
+
+// CheckGreater is the non-fatal counterpart to Greater.
+func CheckGreater(t Tester, a, b interface{}, extra ...interface{}) bool {
+	checkHelper(t).Helper()
+	return checkCompare(t, a, b, func(c int) bool { return c > 0 }, "greater than", extra)
+}
+
+// GreaterOrEqual ensures a is greater than or equal to b. a and b may be any
+// signed/unsigned integer or float type, and need not be the same type as
+// each other.
+func GreaterOrEqual(t Fataler, a, b interface{}, extra ...interface{}) {
+	helper(t).Helper()
+	compare(t, a, b, func(c int) bool { return c >= 0 }, "greater than or equal to", extra)
+}
+
+// CheckGreaterOrEqual is the non-fatal counterpart to GreaterOrEqual.
+func CheckGreaterOrEqual(t Tester, a, b interface{}, extra ...interface{}) bool {
+	checkHelper(t).Helper()
+	return checkCompare(t, a, b, func(c int) bool { return c >= 0 }, "greater than or equal to", extra)
+}
+
+// Less ensures a is less than b. a and b may be any signed/unsigned integer
+// or float type, and need not be the same type as each other.
+func Less(t Fataler, a, b interface{}, extra ...interface{}) {
+	helper(t).Helper()
+	compare(t, a, b, func(c int) bool { return c < 0 }, "less than", extra)
+}
+
+// CheckLess is the non-fatal counterpart to Less.
+func CheckLess(t Tester, a, b interface{}, extra ...interface{}) bool {
+	checkHelper(t).Helper()
+	return checkCompare(t, a, b, func(c int) bool { return c < 0 }, "less than", extra)
+}
+
+// LessOrEqual ensures a is less than or equal to b. a and b may be any
+// signed/unsigned integer or float type, and need not be the same type as
+// each other.
+func LessOrEqual(t Fataler, a, b interface{}, extra ...interface{}) {
+	helper(t).Helper()
+	compare(t, a, b, func(c int) bool { return c <= 0 }, "less than or equal to", extra)
+}
+
+// CheckLessOrEqual is the non-fatal counterpart to LessOrEqual.
+func CheckLessOrEqual(t Tester, a, b interface{}, extra ...interface{}) bool {
+	checkHelper(t).Helper()
+	return checkCompare(t, a, b, func(c int) bool { return c <= 0 }, "less than or equal to", extra)
+}
+
+// compare is the shared core of Greater/GreaterOrEqual/Less/LessOrEqual. ok
+// is handed the result of comparing a to b (negative, zero or positive).
+func compare(t Fataler, a, b interface{}, ok func(c int) bool, verb string, extra []interface{}) {
+	helper(t).Helper()
+	c, failed := compareCond(a, b, ok, verb, extra)
+	if failed {
+		fatal(t, c)
+	}
+}
+
+// checkCompare is the shared core of CheckGreater/CheckGreaterOrEqual/
+// CheckLess/CheckLessOrEqual.
+func checkCompare(t Tester, a, b interface{}, ok func(c int) bool, verb string, extra []interface{}) bool {
+	checkHelper(t).Helper()
+	c, failed := compareCond(a, b, ok, verb, extra)
+	if failed {
+		return errorf(t, c)
+	}
+	return true
+}
+
+// compareCond builds the failure cond for an ordered comparison, or reports
+// failed == false if the comparison holds.
+func compareCond(a, b interface{}, ok func(c int) bool, verb string, extra []interface{}) (c cond, failed bool) {
+	an, aok := toNumValue(a)
+	bn, bok := toNumValue(b)
+	if !aok || !bok {
+		return cond{
+			Format:     "cannot compare values of type %T and %T",
+			FormatArgs: []interface{}{a, b},
+			Extra:      extra,
+		}, true
+	}
+	if ok(compareNumValues(an, bn)) {
+		return cond{}, false
+	}
+	return cond{
+		Format:     "expected %s to be " + verb + " %s",
+		FormatArgs: []interface{}{tsdump(a), tsdump(b)},
+		Extra:      extra,
+	}, true
+}
+
+// numKind identifies which field of numValue holds the value.
+type numKind int
+
+const (
+	numInt numKind = iota
+	numUint
+	numFloat
+)
+
+// numValue holds a signed/unsigned integer or float value without going
+// through a lossy common representation, so same-kind values can be compared
+// exactly regardless of magnitude.
+type numValue struct {
+	kind numKind
+	i    int64
+	u    uint64
+	f    float64
+}
+
+// toNumValue converts a signed/unsigned integer or float value to a
+// numValue, reporting false if v isn't one of those kinds.
+func toNumValue(v interface{}) (numValue, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return numValue{kind: numInt, i: rv.Int()}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return numValue{kind: numUint, u: rv.Uint()}, true
+	case reflect.Float32, reflect.Float64:
+		return numValue{kind: numFloat, f: rv.Float()}, true
+	default:
+		return numValue{}, false
+	}
+}
+
+// asFloat64 returns v's value as a float64, for comparisons against a value
+// of a different kind.
+func (v numValue) asFloat64() float64 {
+	switch v.kind {
+	case numInt:
+		return float64(v.i)
+	case numUint:
+		return float64(v.u)
+	default:
+		return v.f
+	}
+}
+
+// compareNumValues compares a to b, returning a negative number, zero or a
+// positive number as a is less than, equal to or greater than b. Integers,
+// signed or unsigned, are compared as int64/uint64 so values beyond 2^53
+// (e.g. large IDs or nanosecond timestamps) don't lose precision; float64 is
+// used only when at least one of the two values is a float.
+func compareNumValues(a, b numValue) int {
+	switch {
+	case a.kind == numInt && b.kind == numInt:
+		return cmpInt64(a.i, b.i)
+	case a.kind == numUint && b.kind == numUint:
+		return cmpUint64(a.u, b.u)
+	case a.kind == numInt && b.kind == numUint:
+		if a.i < 0 {
+			return -1
+		}
+		return cmpUint64(uint64(a.i), b.u)
+	case a.kind == numUint && b.kind == numInt:
+		if b.i < 0 {
+			return 1
+		}
+		return cmpUint64(a.u, uint64(b.i))
+	default:
+		return cmpFloat64(a.asFloat64(), b.asFloat64())
+	}
+}
+
+func cmpInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func cmpUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func cmpFloat64(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// InDelta ensures actual is within delta of expected.
+func InDelta(t Fataler, expected, actual, delta float64, a ...interface{}) {
+	helper(t).Helper()
+	if c, failed := deltaCond(expected, actual, delta, a); failed {
+		fatal(t, c)
+	}
+}
+
+// CheckInDelta is the non-fatal counterpart to InDelta.
+func CheckInDelta(t Tester, expected, actual, delta float64, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	if c, failed := deltaCond(expected, actual, delta, a); failed {
+		return errorf(t, c)
+	}
+	return true
+}
+
+func deltaCond(expected, actual, delta float64, extra []interface{}) (c cond, failed bool) {
+	diff := actual - expected
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff <= delta {
+		return cond{}, false
+	}
+	return cond{
+		Format:     "expected %v to be within %v of %v but the difference was %v",
+		FormatArgs: []interface{}{actual, delta, expected, diff},
+		Extra:      extra,
+	}, true
+}
+
+// InEpsilon ensures actual is within epsilon relative error of expected,
+// i.e. |actual-expected|/|expected| <= epsilon.
+func InEpsilon(t Fataler, expected, actual, epsilon float64, a ...interface{}) {
+	helper(t).Helper()
+	c, failed := epsilonCond(expected, actual, epsilon, a)
+	if failed {
+		fatal(t, c)
+	}
+}
+
+// CheckInEpsilon is the non-fatal counterpart to InEpsilon.
+func CheckInEpsilon(t Tester, expected, actual, epsilon float64, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	c, failed := epsilonCond(expected, actual, epsilon, a)
+	if failed {
+		return errorf(t, c)
+	}
+	return true
+}
+
+func epsilonCond(expected, actual, epsilon float64, extra []interface{}) (c cond, failed bool) {
+	if expected == 0 {
+		return cond{
+			Format: "cannot compute a relative error against an expected value of 0",
+			Extra:  extra,
+		}, true
+	}
+	relErr := (actual - expected) / expected
+	if relErr < 0 {
+		relErr = -relErr
+	}
+	if relErr <= epsilon {
+		return cond{}, false
+	}
+	return cond{
+		Format:     "expected %v to be within relative error %v of %v but the relative error was %v",
+		FormatArgs: []interface{}{actual, epsilon, expected, relErr},
+		Extra:      extra,
+	}, true
+}
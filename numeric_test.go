@@ -0,0 +1,104 @@
+package ensure
+
+import "testing"
+
+func TestGreater(t *testing.T) {
+	Greater(t, 2, 1)
+	Greater(t, int32(2), int64(1))
+	Greater(t, 2.5, 2)
+}
+
+func TestGreaterLargeInt64Precision(t *testing.T) {
+	// These two values collapse to the same float64, so this only passes if
+	// the comparison stays in int64/uint64 rather than going through float64.
+	Greater(t, int64(9007199254740993), int64(9007199254740992))
+	Greater(t, uint64(9007199254740993), uint64(9007199254740992))
+}
+
+func TestGreaterFailure(t *testing.T) {
+	var c capture
+	Greater(&c, 1, 2)
+	c.Equal(t, `expected (int) 1 to be greater than (int) 2`)
+}
+
+func TestGreaterOrEqual(t *testing.T) {
+	GreaterOrEqual(t, 2, 2)
+	GreaterOrEqual(t, 3, 2)
+}
+
+func TestLess(t *testing.T) {
+	Less(t, 1, 2)
+}
+
+func TestLessOrEqual(t *testing.T) {
+	LessOrEqual(t, 2, 2)
+	LessOrEqual(t, 1, 2)
+}
+
+func TestCompareUnsupportedType(t *testing.T) {
+	var c capture
+	Greater(&c, "a", "b")
+	c.Equal(t, "cannot compare values of type string and string")
+}
+
+func TestCheckGreater(t *testing.T) {
+	True(t, CheckGreater(t, 2, 1))
+
+	var c capture
+	False(t, CheckGreater(&c, 1, 2))
+}
+
+func TestCheckGreaterOrEqual(t *testing.T) {
+	True(t, CheckGreaterOrEqual(t, 2, 2))
+
+	var c capture
+	False(t, CheckGreaterOrEqual(&c, 1, 2))
+}
+
+func TestCheckLess(t *testing.T) {
+	True(t, CheckLess(t, 1, 2))
+
+	var c capture
+	False(t, CheckLess(&c, 2, 1))
+}
+
+func TestCheckLessOrEqual(t *testing.T) {
+	True(t, CheckLessOrEqual(t, 2, 2))
+
+	var c capture
+	False(t, CheckLessOrEqual(&c, 2, 1))
+}
+
+func TestInDelta(t *testing.T) {
+	InDelta(t, 1.0, 1.05, 0.1)
+}
+
+func TestInDeltaFailure(t *testing.T) {
+	var c capture
+	InDelta(&c, 1.0, 2.0, 0.1)
+	c.Equal(t, "expected 2 to be within 0.1 of 1 but the difference was 1")
+}
+
+func TestCheckInDelta(t *testing.T) {
+	True(t, CheckInDelta(t, 1.0, 1.05, 0.1))
+
+	var c capture
+	False(t, CheckInDelta(&c, 1.0, 2.0, 0.1))
+}
+
+func TestInEpsilon(t *testing.T) {
+	InEpsilon(t, 100.0, 105.0, 0.1)
+}
+
+func TestInEpsilonZeroExpected(t *testing.T) {
+	var c capture
+	InEpsilon(&c, 0, 1, 0.1)
+	c.Equal(t, "cannot compute a relative error against an expected value of 0")
+}
+
+func TestCheckInEpsilon(t *testing.T) {
+	True(t, CheckInEpsilon(t, 100.0, 105.0, 0.1))
+
+	var c capture
+	False(t, CheckInEpsilon(&c, 100.0, 200.0, 0.1))
+}
@@ -0,0 +1,129 @@
+package ensure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"gopkg.in/yaml.v2"
+)
+
+// EqualValues ensures actual and expected are equal, either via
+// reflect.DeepEqual or, failing that, by converting expected to actual's
+// type when the two are convertible (so int32(5) and int64(5) are
+// considered equal).
+func EqualValues(t Fataler, actual, expected interface{}, a ...interface{}) {
+	helper(t).Helper()
+	if !objectsAreEqualValues(actual, expected) {
+		fatal(t, deepEqualCond(actual, expected, a))
+	}
+}
+
+// CheckEqualValues is the non-fatal counterpart to EqualValues.
+func CheckEqualValues(t Tester, actual, expected interface{}, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	if !objectsAreEqualValues(actual, expected) {
+		return errorf(t, deepEqualCond(actual, expected, a))
+	}
+	return true
+}
+
+// objectsAreEqualValues reports whether actual and expected hold the same
+// value, allowing for a type conversion on one side when they aren't
+// directly comparable (e.g. int32(5) and int64(5)). []byte is special cased
+// to use bytes.Equal, with nil only considered equal to nil.
+func objectsAreEqualValues(actual, expected interface{}) bool {
+	if ab, ok := actual.([]byte); ok {
+		eb, ok := expected.([]byte)
+		if !ok {
+			return false
+		}
+		if (ab == nil) != (eb == nil) {
+			return false
+		}
+		return bytes.Equal(ab, eb)
+	}
+
+	if reflect.DeepEqual(actual, expected) {
+		return true
+	}
+
+	actualValue := reflect.ValueOf(actual)
+	expectedValue := reflect.ValueOf(expected)
+	if !actualValue.IsValid() || !expectedValue.IsValid() {
+		return false
+	}
+	if !expectedValue.Type().ConvertibleTo(actualValue.Type()) {
+		return false
+	}
+	return reflect.DeepEqual(actualValue.Interface(), expectedValue.Convert(actualValue.Type()).Interface())
+}
+
+// decodeForEqual decodes actualRaw and expectedRaw with unmarshal, for use
+// by JSONEqual/CheckJSONEqual and YAMLEqual/CheckYAMLEqual.
+func decodeForEqual(unmarshal func([]byte, interface{}) error, actualRaw, expectedRaw []byte) (actual, expected interface{}, err error) {
+	if err := unmarshal(actualRaw, &actual); err != nil {
+		return nil, nil, fmt.Errorf("could not decode actual: %+v", err)
+	}
+	if err := unmarshal(expectedRaw, &expected); err != nil {
+		return nil, nil, fmt.Errorf("could not decode expected: %+v", err)
+	}
+	return actual, expected, nil
+}
+
+// JSONEqual ensures actualJSON and expectedJSON decode into DeepEqual
+// values, so tests can compare serialized JSON payloads without caring
+// about key order or whitespace.
+func JSONEqual(t Fataler, actualJSON, expectedJSON []byte, a ...interface{}) {
+	helper(t).Helper()
+	actual, expected, err := decodeForEqual(json.Unmarshal, actualJSON, expectedJSON)
+	if err != nil {
+		fatal(t, cond{Format: "%s", FormatArgs: []interface{}{err}, Extra: a})
+		return
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		fatal(t, deepEqualCond(actual, expected, a))
+	}
+}
+
+// CheckJSONEqual is the non-fatal counterpart to JSONEqual.
+func CheckJSONEqual(t Tester, actualJSON, expectedJSON []byte, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	actual, expected, err := decodeForEqual(json.Unmarshal, actualJSON, expectedJSON)
+	if err != nil {
+		return errorf(t, cond{Format: "%s", FormatArgs: []interface{}{err}, Extra: a})
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		return errorf(t, deepEqualCond(actual, expected, a))
+	}
+	return true
+}
+
+// YAMLEqual ensures actualYAML and expectedYAML decode into DeepEqual
+// values, so tests can compare serialized YAML documents without caring
+// about key order or whitespace.
+func YAMLEqual(t Fataler, actualYAML, expectedYAML []byte, a ...interface{}) {
+	helper(t).Helper()
+	actual, expected, err := decodeForEqual(yaml.Unmarshal, actualYAML, expectedYAML)
+	if err != nil {
+		fatal(t, cond{Format: "%s", FormatArgs: []interface{}{err}, Extra: a})
+		return
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		fatal(t, deepEqualCond(actual, expected, a))
+	}
+}
+
+// CheckYAMLEqual is the non-fatal counterpart to YAMLEqual.
+func CheckYAMLEqual(t Tester, actualYAML, expectedYAML []byte, a ...interface{}) bool {
+	checkHelper(t).Helper()
+	actual, expected, err := decodeForEqual(yaml.Unmarshal, actualYAML, expectedYAML)
+	if err != nil {
+		return errorf(t, cond{Format: "%s", FormatArgs: []interface{}{err}, Extra: a})
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		return errorf(t, deepEqualCond(actual, expected, a))
+	}
+	return true
+}
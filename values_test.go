@@ -0,0 +1,47 @@
+package ensure
+
+import "testing"
+
+func TestEqualValuesConvertibleTypes(t *testing.T) {
+	True(t, CheckEqualValues(t, int64(5), int32(5)))
+}
+
+func TestEqualValuesMismatch(t *testing.T) {
+	var c capture
+	EqualValues(&c, 5, "5")
+	c.Contains(t, "expected these to be equal:")
+}
+
+func TestEqualValuesBytes(t *testing.T) {
+	True(t, CheckEqualValues(t, []byte("foo"), []byte("foo")))
+	True(t, CheckEqualValues(t, []byte(nil), []byte(nil)))
+
+	var c capture
+	False(t, CheckEqualValues(&c, []byte(nil), []byte{}))
+}
+
+func TestJSONEqual(t *testing.T) {
+	JSONEqual(t, []byte(`{"a":1,"b":2}`), []byte(`{"b":2,"a":1}`))
+}
+
+func TestJSONEqualMismatch(t *testing.T) {
+	var c capture
+	JSONEqual(&c, []byte(`{"a":1}`), []byte(`{"a":2}`))
+	c.Contains(t, "expected these to be equal:")
+}
+
+func TestJSONEqualDecodeError(t *testing.T) {
+	var c capture
+	JSONEqual(&c, []byte(`not json`), []byte(`{}`))
+	c.Contains(t, "could not decode actual:")
+}
+
+func TestYAMLEqual(t *testing.T) {
+	YAMLEqual(t, []byte("a: 1\nb: 2\n"), []byte("b: 2\na: 1\n"))
+}
+
+func TestYAMLEqualMismatch(t *testing.T) {
+	var c capture
+	YAMLEqual(&c, []byte("a: 1\n"), []byte("a: 2\n"))
+	c.Contains(t, "expected these to be equal:")
+}